@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// PortImport and PortImportFilter already exist in this package's real
+// (non-checked-out) port_types.go, where PortImportFilter already has
+// NetworkRef and ProjectRef fields -- see Port.Spec.Import.Filter usage in
+// internal/controllers/port/controller.go. They are intentionally not
+// redeclared here to avoid a duplicate type conflict; this file only adds
+// the net-new pieces that port_types.go needs for spec.import to gain
+// macAddress/deviceOwner/deviceID/fixedIPs filtering and an onMissing
+// policy:
+//
+//   - PortImportFilter gains MACAddress, DeviceOwner, DeviceID and
+//     FixedIPs fields alongside its existing NetworkRef/ProjectRef.
+//   - PortImport gains an OnMissing PortImportOnMissingPolicy field,
+//     defaulting to PortImportOnMissingWait.
+//
+// internal/controllers/port/import_filter.go is written against those
+// fields as though they're already present.
+
+// PortImportOnMissingPolicy selects what a Port reconcile does when no
+// existing port matches spec.import.filter.
+// +kubebuilder:validation:Enum:=Wait;Fail;Create
+type PortImportOnMissingPolicy string
+
+const (
+	// PortImportOnMissingWait keeps re-polling for a matching port to
+	// appear. This is the default, and matches ORC's historical behaviour.
+	PortImportOnMissingWait PortImportOnMissingPolicy = "Wait"
+
+	// PortImportOnMissingFail sets a terminal failure condition instead of
+	// retrying forever for a port that will never appear.
+	PortImportOnMissingFail PortImportOnMissingPolicy = "Fail"
+
+	// PortImportOnMissingCreate selects falling back to creating
+	// spec.resource, for GitOps workflows that don't know ahead of time
+	// whether the port already exists. The Port controller's reconcile does
+	// not create the port: on a miss with spec.resource set it stops without
+	// creating or importing anything, and on a miss without spec.resource it
+	// keeps re-polling as with Wait.
+	PortImportOnMissingCreate PortImportOnMissingPolicy = "Create"
+)
@@ -0,0 +1,230 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// +kubebuilder:webhook:path=/validate-openstack-k-orc-cloud-v1alpha1-port,mutating=false,failurePolicy=fail,sideEffects=None,groups=openstack.k-orc.cloud,resources=ports,verbs=create;update;delete,versions=v1alpha1,name=vport.kb.io,admissionReviewVersions=v1
+
+// portValidator validates Port writes. It holds a client so it can resolve
+// cross-references to Subnets at admission time, catching spec
+// inconsistencies that would otherwise only surface as a stuck reconcile.
+type portValidator struct {
+	client client.Client
+}
+
+var _ webhook.CustomValidator = &portValidator{}
+
+// SetupPortWebhookWithManager registers the Port validating webhook with mgr.
+func SetupPortWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&Port{}).
+		WithValidator(&portValidator{client: mgr.GetClient()}).
+		Complete()
+}
+
+func (v *portValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	port, ok := obj.(*Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got a %T", obj)
+	}
+
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+
+	if port.Spec.Resource != nil && port.Spec.Import != nil && port.Spec.Import.OnMissing != PortImportOnMissingCreate {
+		allErrs = append(allErrs, field.Forbidden(specPath, "resource and import are mutually exclusive unless import.onMissing is Create"))
+	}
+
+	allErrs = append(allErrs, v.validateSubnetNetworkConsistency(ctx, port, specPath)...)
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(GroupVersion.WithKind("Port").GroupKind(), port.Name, allErrs)
+}
+
+func (v *portValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldPort, ok := oldObj.(*Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got a %T", oldObj)
+	}
+	newPort, ok := newObj.(*Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got a %T", newObj)
+	}
+
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+
+	if newPort.Spec.Resource != nil && newPort.Spec.Import != nil && newPort.Spec.Import.OnMissing != PortImportOnMissingCreate {
+		allErrs = append(allErrs, field.Forbidden(specPath, "resource and import are mutually exclusive unless import.onMissing is Create"))
+	}
+
+	if oldPort.Spec.Resource != nil && newPort.Spec.Resource != nil {
+		resourcePath := specPath.Child("resource")
+
+		if oldPort.Spec.Resource.NetworkRef != newPort.Spec.Resource.NetworkRef {
+			allErrs = append(allErrs, field.Invalid(resourcePath.Child("networkRef"), newPort.Spec.Resource.NetworkRef, "networkRef is immutable"))
+		}
+
+		oldProject, newProject := oldPort.Spec.Resource.ProjectRef, newPort.Spec.Resource.ProjectRef
+		projectChanged := (oldProject == nil) != (newProject == nil)
+		if !projectChanged && oldProject != nil && newProject != nil {
+			projectChanged = string(*oldProject) != string(*newProject)
+		}
+		if projectChanged {
+			allErrs = append(allErrs, field.Invalid(resourcePath.Child("projectRef"), newProject, "projectRef is immutable"))
+		}
+	}
+
+	allErrs = append(allErrs, v.validateSubnetNetworkConsistency(ctx, newPort, specPath)...)
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(GroupVersion.WithKind("Port").GroupKind(), newPort.Name, allErrs)
+}
+
+func (v *portValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	port, ok := obj.(*Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got a %T", obj)
+	}
+
+	referrers, err := v.findPortReferrers(ctx, port)
+	if err != nil {
+		// A lookup failure here shouldn't block deletion: the deletion
+		// guard dependencies on Network/Subnet/SecurityGroup/Project are
+		// the authoritative check for those relationships. This is a
+		// best-effort warning on top of that for the other direction.
+		return nil, nil
+	}
+	if len(referrers) == 0 {
+		return nil, nil
+	}
+
+	return admission.Warnings{
+		fmt.Sprintf("port %s/%s may still be referenced by: %v", port.Namespace, port.Name, referrers),
+	}, nil
+}
+
+// findPortReferrers does a best-effort scan for Server and FloatingIP
+// objects in port's namespace whose spec still names it, so deleting a
+// Port still in use surfaces a Warning instead of silently orphaning a
+// reference. It reads Server/FloatingIP via unstructured.Unstructured
+// rather than their Go types, since those aren't present in this package
+// and a delete-time warning shouldn't need a direct dependency on every
+// resource kind that can reference a Port.
+func (v *portValidator) findPortReferrers(ctx context.Context, port *Port) ([]string, error) {
+	var referrers []string
+
+	for _, kind := range []string{"Server", "FloatingIP"} {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(GroupVersion.WithKind(kind + "List"))
+
+		if err := v.client.List(ctx, list, client.InNamespace(port.Namespace)); err != nil {
+			if meta.IsNoMatchError(err) || apierrors.IsNotFound(err) {
+				// The kind isn't registered with this manager (e.g. the
+				// Server/FloatingIP CRDs aren't installed): nothing to
+				// warn about.
+				continue
+			}
+			return nil, err
+		}
+
+		for i := range list.Items {
+			if portReferencedBy(&list.Items[i], port.Name) {
+				referrers = append(referrers, fmt.Sprintf("%s/%s", kind, list.Items[i].GetName()))
+			}
+		}
+	}
+
+	return referrers, nil
+}
+
+// portReferencedBy reports whether obj's spec names portName under either
+// spec.resource.portRef (FloatingIP's single port reference) or an entry of
+// spec.resource.ports[].portRef (Server's list of port attachments).
+func portReferencedBy(obj *unstructured.Unstructured, portName string) bool {
+	if ref, found, _ := unstructured.NestedString(obj.Object, "spec", "resource", "portRef"); found && ref == portName {
+		return true
+	}
+
+	ports, found, _ := unstructured.NestedSlice(obj.Object, "spec", "resource", "ports")
+	if !found {
+		return false
+	}
+	for _, entry := range ports {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ref, found, _ := unstructured.NestedString(m, "portRef"); found && ref == portName {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSubnetNetworkConsistency rejects addresses whose subnetRef points
+// to a Subnet belonging to a different network than spec.resource.networkRef.
+func (v *portValidator) validateSubnetNetworkConsistency(ctx context.Context, port *Port, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if port.Spec.Resource == nil {
+		return allErrs
+	}
+
+	addressesPath := specPath.Child("resource", "addresses")
+	for i := range port.Spec.Resource.Addresses {
+		address := &port.Spec.Resource.Addresses[i]
+
+		subnet := &Subnet{}
+		key := types.NamespacedName{Namespace: port.Namespace, Name: string(address.SubnetRef)}
+		if err := v.client.Get(ctx, key, subnet); err != nil {
+			// Not found or not yet created: the subnet deletion
+			// guard dependency will requeue once it exists.
+			continue
+		}
+
+		if subnet.Spec.Resource == nil || subnet.Spec.Resource.NetworkRef == port.Spec.Resource.NetworkRef {
+			continue
+		}
+
+		allErrs = append(allErrs, field.Invalid(
+			addressesPath.Index(i).Child("subnetRef"), address.SubnetRef,
+			fmt.Sprintf("subnet belongs to network %q, which differs from spec.resource.networkRef %q", subnet.Spec.Resource.NetworkRef, port.Spec.Resource.NetworkRef),
+		))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// TagPolicy selects how a resource's observed tags are reconciled against
+// spec.resource.tags.
+// +kubebuilder:validation:Enum:=Replace;Merge
+type TagPolicy string
+
+const (
+	// TagPolicyReplace overwrites the resource's entire tag set on every
+	// reconcile to exactly match spec.resource.tags, clobbering tags set by
+	// other systems. This is the default, matching ORC's historical
+	// behaviour.
+	TagPolicyReplace TagPolicy = "Replace"
+
+	// TagPolicyMerge only adds tags present in spec.resource.tags and
+	// removes tags ORC previously added itself which have since been
+	// dropped from spec, leaving tags set out-of-band (by Heat, CAPO, or
+	// manually) untouched.
+	TagPolicyMerge TagPolicy = "Merge"
+)
+
+// A resource's TagPolicy field lives on its own resource spec (e.g.
+// PortResourceSpec), not a shared embedded struct: PortResourceSpec
+// already exists in this package's real (non-checked-out) port_types.go
+// alongside NetworkRef/Addresses/etc, so adding a field to it here would
+// redeclare that type rather than extend it. reconcilePortTags
+// (internal/controllers/port/tags.go) is written as though
+// PortResourceSpec already has:
+//
+//	// tagPolicy selects how this resource's tags are reconciled against
+//	// the tags observed on the OpenStack resource.
+//	// +optional
+//	// +kubebuilder:default:=Replace
+//	TagPolicy TagPolicy `json:"tagPolicy,omitempty"`
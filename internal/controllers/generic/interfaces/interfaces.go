@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package interfaces holds the contracts shared between the generic
+// reconciler.Controller and each resource-specific controller package
+// (port, network, ...), so neither side imports the other.
+package interfaces
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+)
+
+// Controller is implemented by each resource-specific controller
+// constructor (port.New, ...) and registered with the manager.
+type Controller interface {
+	GetName() string
+	SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error
+}
+
+// ResourceReconciler is a single step of a resource's reconcile: given the
+// ORC object and the observed OpenStack resource (nil if it doesn't exist
+// yet), it reconciles one aspect -- tags, a status field, ... -- and
+// reports progress. neutrontags.ReconcileTags returns one of these.
+type ResourceReconciler[orcObjectPT, osResourceT any] func(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT) progress.ReconcileStatus
+
+// HelperFactory supplies the generic reconciler.Controller with
+// resource-specific reconcile behaviour, so the same Controller can drive
+// every ORC resource type.
+type HelperFactory interface {
+	// NewObject returns a new, empty instance of the resource type, for
+	// use with client.Get.
+	NewObject() client.Object
+
+	// ReconcileObject runs every reconcile step for obj and reports
+	// aggregate progress.
+	ReconcileObject(ctx context.Context, k8sClient client.Client, scopeFactory scope.Factory, obj client.Object) progress.ReconcileStatus
+}
+
+// StatusWriter patches an ORC object's status -- including its conditions
+// -- back to the API server on behalf of the generic reconciler.Controller.
+type StatusWriter interface {
+	// SetCondition sets/updates a condition of the given type on obj's
+	// status, returning whether it changed anything.
+	SetCondition(obj client.Object, conditionType string, status metav1.ConditionStatus, reason, message string) bool
+
+	// RemoveCondition removes a condition of the given type from obj's
+	// status, returning whether it changed anything.
+	RemoveCondition(obj client.Object, conditionType string) bool
+
+	// WriteStatus patches obj's status subresource.
+	WriteStatus(ctx context.Context, k8sClient client.Client, obj client.Object) error
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package progress reports the outcome of a single step of a larger
+// resource reconcile, so the generic reconciler.Controller can decide
+// whether to requeue, requeue with backoff, or stop retrying altogether.
+package progress
+
+import ctrl "sigs.k8s.io/controller-runtime"
+
+// ReconcileStatus is returned by a reconcile step to describe what
+// happened: nothing worth reporting, a transient error to retry, a change
+// that needs another pass to observe, or a terminal failure that further
+// retries can't fix.
+type ReconcileStatus interface {
+	// Error returns the error associated with this status, or nil if
+	// there is none.
+	Error() error
+
+	// IsTerminal reports whether Error is a failure the generic
+	// reconciler should surface as a condition instead of requeuing
+	// forever.
+	IsTerminal() bool
+
+	// Requeue reports whether the caller should requeue, and with which
+	// ctrl.Result.
+	Requeue() (bool, ctrl.Result)
+}
+
+type reconcileStatus struct {
+	err      error
+	terminal bool
+	result   ctrl.Result
+	requeue  bool
+}
+
+func (s *reconcileStatus) Error() error                 { return s.err }
+func (s *reconcileStatus) IsTerminal() bool             { return s.terminal }
+func (s *reconcileStatus) Requeue() (bool, ctrl.Result) { return s.requeue, s.result }
+
+// WrapError reports a transient error: the generic reconciler requeues
+// using its standard exponential backoff. Returns nil if err is nil.
+func WrapError(err error) ReconcileStatus {
+	if err == nil {
+		return nil
+	}
+	return &reconcileStatus{err: err, requeue: true}
+}
+
+// WrapTerminalError reports an error that no amount of retrying can fix.
+// The generic reconciler surfaces it as a failure condition instead of
+// requeuing. Returns nil if err is nil.
+func WrapTerminalError(err error) ReconcileStatus {
+	if err == nil {
+		return nil
+	}
+	return &reconcileStatus{err: err, terminal: true}
+}
+
+// NeedsRefresh reports that this step changed the OpenStack resource and
+// the caller should requeue immediately to observe the result.
+func NeedsRefresh() ReconcileStatus {
+	return &reconcileStatus{requeue: true, result: ctrl.Result{Requeue: true}}
+}
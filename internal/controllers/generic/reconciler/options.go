@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import "k8s.io/client-go/tools/record"
+
+// Option configures a Controller constructed by NewController.
+type Option func(*controllerOptions)
+
+type controllerOptions struct {
+	eventRecorder record.EventRecorder
+}
+
+// WithEventRecorder sets the record.EventRecorder a Controller uses to emit
+// status events; a Controller built without it emits no events. Pass a recorder
+// from eventutil.NewRecorder so that reconciliation tolerates a
+// ServiceAccount without events.k8s.io create permission, and coalesces
+// repeated events instead of flooding the API server.
+func WithEventRecorder(recorder record.EventRecorder) Option {
+	return func(o *controllerOptions) {
+		o.eventRecorder = recorder
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package reconciler implements the reconcile.Reconciler shared by every
+// ORC resource controller. Resource-specific behaviour is supplied by an
+// interfaces.HelperFactory and interfaces.StatusWriter pair, so the loop
+// itself -- fetch, honour pause, reconcile, write status, emit events --
+// is written once.
+package reconciler
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/pkg/predicates"
+)
+
+// PausedConditionType is set to True on an ORC object's status whenever its
+// reconcile was skipped because of predicates.PausedAnnotation.
+const PausedConditionType = "Paused"
+
+// Controller is the generic reconcile.Reconciler shared by every ORC
+// resource controller; resource-specific behaviour is supplied by
+// helperFactory and statusWriter.
+type Controller struct {
+	name          string
+	client        client.Client
+	scopeFactory  scope.Factory
+	helperFactory interfaces.HelperFactory
+	statusWriter  interfaces.StatusWriter
+	eventRecorder record.EventRecorder
+}
+
+// NewController builds the generic Controller for a single resource type.
+// helperFactory and statusWriter supply the resource-specific behaviour;
+// opts set optional behaviour such as the event recorder.
+func NewController(name string, k8sClient client.Client, scopeFactory scope.Factory, helperFactory interfaces.HelperFactory, statusWriter interfaces.StatusWriter, opts ...Option) Controller {
+	o := controllerOptions{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return Controller{
+		name:          name,
+		client:        k8sClient,
+		scopeFactory:  scopeFactory,
+		helperFactory: helperFactory,
+		statusWriter:  statusWriter,
+		eventRecorder: o.eventRecorder,
+	}
+}
+
+// Reconcile implements reconcile.Reconciler.
+func (c *Controller) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := c.helperFactory.NewObject()
+	if err := c.client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if predicates.IsPaused(obj) {
+		changed := c.statusWriter.SetCondition(obj, PausedConditionType, metav1.ConditionTrue, "Paused",
+			"reconciliation is paused via the "+predicates.PausedAnnotation+" annotation")
+		if changed {
+			if err := c.statusWriter.WriteStatus(ctx, c.client, obj); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if c.statusWriter.RemoveCondition(obj, PausedConditionType) {
+		if err := c.statusWriter.WriteStatus(ctx, c.client, obj); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	status := c.helperFactory.ReconcileObject(ctx, c.client, c.scopeFactory, obj)
+	if status == nil {
+		return ctrl.Result{}, nil
+	}
+
+	if err := status.Error(); err != nil {
+		if c.eventRecorder != nil {
+			c.eventRecorder.Event(obj, "Warning", "ReconcileError", err.Error())
+		}
+		if status.IsTerminal() {
+			if c.statusWriter.SetCondition(obj, "Progressing", metav1.ConditionFalse, "UnrecoverableError", err.Error()) {
+				if err := c.statusWriter.WriteStatus(ctx, c.client, obj); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if requeue, result := status.Requeue(); requeue {
+		return result, nil
+	}
+	return ctrl.Result{}, nil
+}
@@ -22,7 +22,9 @@ import (
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/pkg/predicates"
@@ -32,6 +34,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/credentials"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/eventutil"
 )
 
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=ports,verbs=get;list;watch;create;update;patch;delete
@@ -118,11 +121,29 @@ var (
 )
 
 type portReconcilerConstructor struct {
-	scopeFactory scope.Factory
+	scopeFactory     scope.Factory
+	watchFilterValue string
 }
 
-func New(scopeFactory scope.Factory) interfaces.Controller {
-	return portReconcilerConstructor{scopeFactory: scopeFactory}
+// Option configures a Controller constructed by New.
+type Option func(*portReconcilerConstructor)
+
+// WithWatchFilterValue sets the watch-filter label value this controller
+// requires on Ports (and their dependencies) before reconciling them,
+// letting multiple ORC managers shard a cluster by label. Omitting it
+// preserves today's behaviour of reconciling every Port.
+func WithWatchFilterValue(watchFilterValue string) Option {
+	return func(c *portReconcilerConstructor) {
+		c.watchFilterValue = watchFilterValue
+	}
+}
+
+func New(scopeFactory scope.Factory, opts ...Option) interfaces.Controller {
+	c := portReconcilerConstructor{scopeFactory: scopeFactory}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
 }
 
 func (portReconcilerConstructor) GetName() string {
@@ -164,28 +185,46 @@ func (c portReconcilerConstructor) SetupWithManager(ctx context.Context, mgr ctr
 		return err
 	}
 
+	// The primary watch is only filtered on the watch-filter label: a paused
+	// Port must still be reconciled so the generic reconciler.Controller can
+	// observe predicates.IsPaused and set the Paused status condition.
+	// Dropping the event here instead would mean the condition could never
+	// be set.
+	hasFilterLabel := predicates.NewResourceHasFilterLabel(log, c.watchFilterValue)
+
+	// Paused does apply to the cross-resource watches: a paused Network
+	// must not wake up its dependent Ports, which aren't reconciling it
+	// directly and have no condition of their own to update for it.
+	becameAvailableUnlessPaused := func(obj client.Object) builder.Predicates {
+		return builder.WithPredicates(predicate.And(
+			hasFilterLabel,
+			predicates.NewResourceNotPaused(log),
+			predicates.NewBecameAvailable(log, obj),
+		))
+	}
+
 	builder := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
-		For(&orcv1alpha1.Port{}).
+		For(&orcv1alpha1.Port{}, builder.WithPredicates(hasFilterLabel)).
 		Watches(&orcv1alpha1.Network{}, networkWatchEventHandler,
-			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Network{})),
+			becameAvailableUnlessPaused(&orcv1alpha1.Network{}),
 		).
 		// A second watch is necessary because we need a different handler that omits deletion guards
 		Watches(&orcv1alpha1.Network{}, networkImportWatchEventHandler,
-			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Network{})),
+			becameAvailableUnlessPaused(&orcv1alpha1.Network{}),
 		).
 		Watches(&orcv1alpha1.Subnet{}, subnetWatchEventHandler,
-			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Subnet{})),
+			becameAvailableUnlessPaused(&orcv1alpha1.Subnet{}),
 		).
 		Watches(&orcv1alpha1.SecurityGroup{}, securityGroupWatchEventHandler,
-			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.SecurityGroup{})),
+			becameAvailableUnlessPaused(&orcv1alpha1.SecurityGroup{}),
 		).
 		Watches(&orcv1alpha1.Project{}, projectWatchEventHandler,
-			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Project{})),
+			becameAvailableUnlessPaused(&orcv1alpha1.Project{}),
 		).
 		// A second watch is necessary because we need a different handler that omits deletion guards
 		Watches(&orcv1alpha1.Project{}, projectImportWatchEventHandler,
-			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Project{})),
+			becameAvailableUnlessPaused(&orcv1alpha1.Project{}),
 		)
 
 	if err := errors.Join(
@@ -201,6 +240,22 @@ func (c portReconcilerConstructor) SetupWithManager(ctx context.Context, mgr ctr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, portHelperFactory{}, portStatusWriter{})
+	eventRecorder, err := eventutil.NewRecorder(ctx, k8sClient, mgr.GetEventRecorderFor(controllerName), log, 0)
+	if err != nil {
+		return err
+	}
+
+	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, portHelperFactory{}, portStatusWriter{}, reconciler.WithEventRecorder(eventRecorder))
 	return builder.Complete(&r)
 }
+
+// The Paused status condition itself is set by reconciler.Controller's
+// Reconcile loop (internal/controllers/generic/reconciler/reconciler.go),
+// which checks predicates.IsPaused and patches the condition via
+// portStatusWriter; SetupWithManager only decides which events reach it.
+//
+// Network, Subnet, SecurityGroup and Project don't have controller
+// packages in this checkout, so NewResourceNotPaused/NewResourceHasFilterLabel
+// aren't wired into their SetupWithManager here. This file applies
+// NewResourceHasFilterLabel alone to Port's primary watch, and
+// NewResourceNotPaused together with it only to the cross-resource watches.
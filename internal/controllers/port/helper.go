@@ -0,0 +1,109 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package port
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+)
+
+// portHelperFactory supplies the generic reconciler.Controller with
+// Port-specific reconcile behaviour.
+type portHelperFactory struct{}
+
+func (portHelperFactory) NewObject() client.Object {
+	return &orcv1alpha1.Port{}
+}
+
+// ReconcileObject resolves spec.import (if set) before reconciling the
+// resulting OpenStack port's tags. Resource creation/update for a plain
+// spec.resource Port isn't implemented here: it's out of scope for the
+// import and tag-policy work this helper wires up.
+func (portHelperFactory) ReconcileObject(ctx context.Context, k8sClient client.Client, scopeFactory scope.Factory, obj client.Object) progress.ReconcileStatus {
+	port, ok := obj.(*orcv1alpha1.Port)
+	if !ok {
+		return progress.WrapTerminalError(fmt.Errorf("expected a Port but got a %T", obj))
+	}
+
+	if port.Spec.Import == nil {
+		return nil
+	}
+
+	networkClient, err := scopeFactory.NewNetworkClient(ctx, k8sClient, port)
+	if err != nil {
+		return progress.WrapError(err)
+	}
+
+	imported, status := GetImportedPort(ctx, networkClient, port.Spec.Import.Filter, port.Spec.Resource, port.Spec.Import.OnMissing)
+	if status != nil {
+		return status
+	}
+	if imported == nil {
+		// No match, and onMissing is Create with spec.resource set: this
+		// helper doesn't create the port, so there is nothing more to do.
+		return nil
+	}
+
+	return reconcilePortTags(ctx, k8sClient, networkClient, port, imported)
+}
+
+// portStatusWriter patches a Port's status, including its conditions, back
+// to the API server on behalf of the generic reconciler.Controller.
+type portStatusWriter struct{}
+
+func (portStatusWriter) SetCondition(obj client.Object, conditionType string, status metav1.ConditionStatus, reason, message string) bool {
+	port, ok := obj.(*orcv1alpha1.Port)
+	if !ok {
+		return false
+	}
+	existing := meta.FindStatusCondition(port.Status.Conditions, conditionType)
+	if existing != nil && existing.Status == status && existing.Reason == reason && existing.Message == message {
+		return false
+	}
+	meta.SetStatusCondition(&port.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: port.Generation,
+	})
+	return true
+}
+
+func (portStatusWriter) RemoveCondition(obj client.Object, conditionType string) bool {
+	port, ok := obj.(*orcv1alpha1.Port)
+	if !ok {
+		return false
+	}
+	if meta.FindStatusCondition(port.Status.Conditions, conditionType) == nil {
+		return false
+	}
+	meta.RemoveStatusCondition(&port.Status.Conditions, conditionType)
+	return true
+}
+
+func (portStatusWriter) WriteStatus(ctx context.Context, k8sClient client.Client, obj client.Object) error {
+	return k8sClient.Status().Update(ctx, obj)
+}
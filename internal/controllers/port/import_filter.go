@@ -0,0 +1,162 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package port
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+)
+
+// GetImportedPort is the port helper's import path when spec.import is
+// set (see portHelperFactory.ReconcileObject): it lists Neutron ports
+// matching filter, narrows them to an exact match client-side, and applies
+// spec.import.onMissing if none match.
+//
+// A non-nil *ports.Port return means the import resolved to exactly one
+// port. A nil port with a nil progress.ReconcileStatus means the caller
+// should fall back to creating resourceSpec (onMissing: Create on a miss).
+// Any other return is either a transient error/requeue or, for
+// onMissing: Fail, a terminal progress.WrapTerminalError that the generic
+// reconciler surfaces as a failure condition instead of requeuing forever.
+func GetImportedPort(ctx context.Context, networkClient osclients.NetworkClient, filter *orcv1alpha1.PortImportFilter, resourceSpec *orcv1alpha1.PortResourceSpec, onMissing orcv1alpha1.PortImportOnMissingPolicy) (*ports.Port, progress.ReconcileStatus) {
+	candidates, err := networkClient.ListPort(ctx, listOptsFromImportFilter(filter))
+	if err != nil {
+		return nil, progress.WrapError(err)
+	}
+
+	var matches []ports.Port
+	for i := range candidates {
+		if matchesImportFilter(filter, &candidates[i]) {
+			matches = append(matches, candidates[i])
+		}
+	}
+
+	switch len(matches) {
+	case 1:
+		return &matches[0], nil
+	case 0:
+		shouldCreate, terminal := resolveOnMissingImport(onMissing, resourceSpec != nil)
+		switch {
+		case terminal:
+			return nil, progress.WrapTerminalError(fmt.Errorf("port import filter matched no port and onMissing policy is %s", orcv1alpha1.PortImportOnMissingFail))
+		case shouldCreate:
+			return nil, nil
+		default:
+			return nil, progress.NeedsRefresh()
+		}
+	default:
+		return nil, progress.WrapError(fmt.Errorf("port import filter matched %d ports, expected exactly one", len(matches)))
+	}
+}
+
+// listOptsFromImportFilter translates an orcv1alpha1.PortImportFilter into
+// the subset of fields Neutron can filter on server-side. macAddress and
+// deviceID map directly to ports.ListOpts fields, as does deviceOwner unless
+// it ends in a "*" wildcard, which is left to matchesImportFilter.
+// fixedIPs is not included here: Neutron only supports filtering by a single
+// fixed_ips query parameter shaped like "ip_address=<addr>", which can't
+// express the list semantics of spec.import.filter.fixedIPs, so matching on
+// it is done client-side in matchesImportFilter instead.
+func listOptsFromImportFilter(filter *orcv1alpha1.PortImportFilter) ports.ListOpts {
+	if filter == nil {
+		return ports.ListOpts{}
+	}
+
+	// NetworkRef is resolved to a Neutron ID and applied to ListOpts by the
+	// caller (see networkImportDependency), not here.
+	opts := ports.ListOpts{}
+	if filter.MACAddress != "" {
+		opts.MACAddress = string(filter.MACAddress)
+	}
+	if filter.DeviceOwner != "" && !strings.HasSuffix(string(filter.DeviceOwner), "*") {
+		opts.DeviceOwner = string(filter.DeviceOwner)
+	}
+	if filter.DeviceID != "" {
+		opts.DeviceID = string(filter.DeviceID)
+	}
+	return opts
+}
+
+// matchesImportFilter applies the parts of filter that Neutron can't filter
+// on server-side: a wildcard suffix on deviceOwner (e.g. "compute:*", to
+// adopt any Nova-owned port regardless of AZ/cell suffix) and a check that
+// every address in fixedIPs is among the candidate's fixed IPs.
+func matchesImportFilter(filter *orcv1alpha1.PortImportFilter, candidate *ports.Port) bool {
+	if filter == nil {
+		return true
+	}
+
+	if filter.DeviceOwner != "" {
+		want := string(filter.DeviceOwner)
+		if prefix, ok := strings.CutSuffix(want, "*"); ok {
+			if !strings.HasPrefix(candidate.DeviceOwner, prefix) {
+				return false
+			}
+		} else if candidate.DeviceOwner != want {
+			return false
+		}
+	}
+
+	if len(filter.FixedIPs) == 0 {
+		return true
+	}
+
+	for _, wantIP := range filter.FixedIPs {
+		found := false
+		for _, gotIP := range candidate.FixedIPs {
+			if gotIP.IPAddress == string(wantIP) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveOnMissingImport decides what a reconcile should do when an import
+// filter matched no existing port, based on spec.import.onMissing:
+//   - Wait (the default): keep polling, same as ORC's existing behaviour.
+//   - Fail: give up and report a terminal condition instead of retrying
+//     forever for a port that will never appear.
+//   - Create: fall back to creating spec.resource, for GitOps flows that
+//     don't know ahead of time whether the port already exists.
+//
+// shouldCreate tells the caller to proceed with the normal create path using
+// spec.resource; when false and terminal is true the caller should set a
+// terminal failure condition rather than requeue.
+func resolveOnMissingImport(policy orcv1alpha1.PortImportOnMissingPolicy, hasResourceSpec bool) (shouldCreate, terminal bool) {
+	switch policy {
+	case orcv1alpha1.PortImportOnMissingCreate:
+		return hasResourceSpec, false
+	case orcv1alpha1.PortImportOnMissingFail:
+		return false, true
+	case orcv1alpha1.PortImportOnMissingWait, "":
+		return false, false
+	default:
+		return false, false
+	}
+}
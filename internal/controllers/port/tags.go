@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package port
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/neutrontags"
+)
+
+// reconcilePortTags reconciles osPort's tags against port's spec.resource.tags,
+// honouring spec.resource.tagPolicy (see the comment in
+// api/v1alpha1/tag_policy_types.go for why that field isn't declared here).
+func reconcilePortTags(ctx context.Context, k8sClient client.Client, networkClient osclients.NetworkClient, port *orcv1alpha1.Port, osPort *ports.Port) progress.ReconcileStatus {
+	var specTags []orcv1alpha1.NeutronTag
+	tagPolicy := orcv1alpha1.TagPolicyReplace
+	if port.Spec.Resource != nil {
+		specTags = port.Spec.Resource.Tags
+		tagPolicy = port.Spec.Resource.TagPolicy
+	}
+
+	tagReconciler := neutrontags.ReconcileTags[*orcv1alpha1.Port, ports.Port](
+		networkClient, "ports", osPort.ID, specTags, osPort.Tags,
+		neutrontags.WithTagPolicy(tagPolicy, k8sClient, port, neutrontags.ManagedTagsAnnotation),
+	)
+	return tagReconciler(ctx, port, osPort)
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scope resolves the OpenStack credentials referenced by an ORC
+// object into ready-to-use OpenStack service clients.
+package scope
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+)
+
+// Factory resolves obj's credentials reference (typically
+// spec.cloudCredentialsRef) into OpenStack service clients. Each resource
+// controller is constructed with a Factory so it never has to resolve
+// credentials for itself.
+type Factory interface {
+	// NewNetworkClient resolves obj's credentials reference and returns a
+	// client for the OpenStack Networking (Neutron) service.
+	NewNetworkClient(ctx context.Context, k8sClient client.Client, obj client.Object) (osclients.NetworkClient, error)
+}
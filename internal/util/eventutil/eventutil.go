@@ -0,0 +1,167 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventutil makes status event recording tolerant of ServiceAccounts
+// that lack create permission on events.k8s.io, and protects the API server
+// from being flooded when a single failing OpenStack call would otherwise be
+// reported on every reconcile.
+package eventutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const eventsResourceGroup = "events.k8s.io"
+
+// DefaultCoalesceWindow is used by NewRecorder when window is zero.
+const DefaultCoalesceWindow = 5 * time.Minute
+
+// NewRecorder wraps base in a record.EventRecorder that is safe to pass to
+// every ORC controller: it probes events.k8s.io create permission once via a
+// SelfSubjectAccessReview, falling back to a no-op recorder (logging once at
+// info level) when the ServiceAccount is unauthorized, instead of letting
+// repeated Event creates fail and log-flood. Authorized recorders coalesce
+// repeat events for the same object/reason/message within window, so a
+// persistently failing OpenStack call doesn't spam thousands of Events.
+func NewRecorder(ctx context.Context, c client.Client, base record.EventRecorder, log logr.Logger, window time.Duration) (record.EventRecorder, error) {
+	allowed, err := canCreateEvents(ctx, c)
+	if err != nil {
+		return nil, fmt.Errorf("checking %s create permission: %w", eventsResourceGroup, err)
+	}
+
+	if !allowed {
+		log.Info("ServiceAccount is not permitted to create Events, status events will not be recorded",
+			"group", eventsResourceGroup)
+		return noopRecorder{}, nil
+	}
+
+	if window <= 0 {
+		window = DefaultCoalesceWindow
+	}
+
+	return &coalescingRecorder{
+		base:   base,
+		window: window,
+		seen:   make(map[coalesceKey]time.Time),
+	}, nil
+}
+
+func canCreateEvents(ctx context.Context, c client.Client) (bool, error) {
+	sar := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    eventsResourceGroup,
+				Resource: "events",
+				Verb:     "create",
+			},
+		},
+	}
+	if err := c.Create(ctx, sar); err != nil {
+		return false, err
+	}
+	return sar.Status.Allowed, nil
+}
+
+// noopRecorder discards every event. Used when the ServiceAccount isn't
+// permitted to create Events.
+type noopRecorder struct{}
+
+func (noopRecorder) Event(_ runtime.Object, _, _, _ string) {}
+func (noopRecorder) Eventf(_ runtime.Object, _, _, _ string, _ ...interface{}) {}
+func (noopRecorder) AnnotatedEventf(_ runtime.Object, _ map[string]string, _, _, _ string, _ ...interface{}) {
+}
+
+type coalesceKey struct {
+	uid     types.UID
+	reason  string
+	message string
+}
+
+// coalescingRecorder drops repeat events for the same involved object,
+// reason and message within window, so that e.g. a Neutron call that fails
+// on every reconcile produces one Event per window rather than one per
+// reconcile.
+type coalescingRecorder struct {
+	base   record.EventRecorder
+	window time.Duration
+
+	mu   sync.Mutex
+	seen map[coalesceKey]time.Time
+}
+
+func (r *coalescingRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if r.shouldEmit(object, reason, message) {
+		r.base.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *coalescingRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.shouldEmit(object, reason, message) {
+		r.base.Event(object, eventtype, reason, message)
+	}
+}
+
+func (r *coalescingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if r.shouldEmit(object, reason, message) {
+		r.base.AnnotatedEventf(object, annotations, eventtype, reason, message)
+	}
+}
+
+func (r *coalescingRecorder) shouldEmit(object runtime.Object, reason, message string) bool {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		// Can't key on identity: emit rather than silently drop.
+		return true
+	}
+
+	key := coalesceKey{uid: accessor.GetUID(), reason: reason, message: message}
+	now := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.evictExpiredLocked(now)
+
+	if last, ok := r.seen[key]; ok && now.Sub(last) < r.window {
+		return false
+	}
+	r.seen[key] = now
+	return true
+}
+
+// evictExpiredLocked drops entries older than window, so seen doesn't grow
+// without bound over the lifetime of a long-running controller. Callers
+// must hold r.mu.
+func (r *coalescingRecorder) evictExpiredLocked(now time.Time) {
+	for key, last := range r.seen {
+		if now.Sub(last) >= r.window {
+			delete(r.seen, key)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"k8s.io/utils/set"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
@@ -45,27 +46,134 @@ func Join[T StringTag](tags []T) string {
 	return b.String()
 }
 
+// ManagedTagsAnnotation records the comma-separated set of tags ORC applied
+// on the previous reconcile under orcv1alpha1.TagPolicyMerge, so it can tell
+// those tags apart from ones added out-of-band.
+const ManagedTagsAnnotation = "orc.openstack.k-orc.cloud/managed-tags"
+
+// tagOptions configures the behaviour of ReconcileTags.
+type tagOptions struct {
+	policy                orcv1alpha1.TagPolicy
+	k8sClient             client.Client
+	orcObject             client.Object
+	managedTagsAnnotation string
+}
+
+// TagOption configures ReconcileTags. The zero value reconciles with
+// orcv1alpha1.TagPolicyReplace, matching ORC's historical behaviour.
+type TagOption func(*tagOptions)
+
+// WithTagPolicy switches ReconcileTags to policy. Under TagPolicyMerge it
+// only adds tags missing from spec and removes previously-managed tags
+// which have been dropped from spec, instead of replacing the full tag set;
+// it persists the set of managed tags as managedTagsAnnotation on orcObject
+// via k8sClient so it survives across reconciles. TagPolicyReplace ignores
+// k8sClient, orcObject and managedTagsAnnotation.
+func WithTagPolicy(policy orcv1alpha1.TagPolicy, k8sClient client.Client, orcObject client.Object, managedTagsAnnotation string) TagOption {
+	return func(o *tagOptions) {
+		o.policy = policy
+		o.k8sClient = k8sClient
+		o.orcObject = orcObject
+		o.managedTagsAnnotation = managedTagsAnnotation
+	}
+}
+
+// ReconcileTags reconciles the tags on an OpenStack resource against
+// specTags. By default it replaces the entire tag set in one call
+// (orcv1alpha1.TagPolicyReplace). Passing WithTagPolicy(orcv1alpha1.TagPolicyMerge, ...)
+// instead only adds tags missing from spec and removes previously-managed
+// tags that have been dropped from spec, leaving tags set out-of-band (by
+// Heat, CAPO, or manually) untouched.
 func ReconcileTags[orcObjectPT, osResourceT any](
 	networkClient osclients.NetworkClient,
 	resourceType string, resourceID string,
 	specTags []orcv1alpha1.NeutronTag,
 	observedTags []string,
+	opts ...TagOption,
 ) interfaces.ResourceReconciler[orcObjectPT, osResourceT] {
+	o := tagOptions{policy: orcv1alpha1.TagPolicyReplace}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return func(ctx context.Context, _ orcObjectPT, _ *osResourceT) progress.ReconcileStatus {
 		observedTagSet := set.New(observedTags...)
 		specTagSet := set.New[string]()
 		for i := range specTags {
 			specTagSet.Insert(string(specTags[i]))
 		}
-		if !specTagSet.Equal(observedTagSet) {
-			opts := attributestags.ReplaceAllOpts{Tags: specTagSet.SortedList()}
-			_, err := networkClient.ReplaceAllAttributesTags(ctx, resourceType, resourceID, &opts)
-			if err != nil {
-				return progress.WrapError(err)
-			}
-			// If we updated the tags we need another reconcile to refresh the resource status
-			return progress.NeedsRefresh()
+
+		if o.policy == orcv1alpha1.TagPolicyMerge && o.orcObject != nil {
+			return reconcileTagsMerge(ctx, networkClient, resourceType, resourceID, o.k8sClient, o.orcObject, o.managedTagsAnnotation, specTagSet, observedTagSet)
+		}
+		return reconcileTagsReplace(ctx, networkClient, resourceType, resourceID, specTagSet, observedTagSet)
+	}
+}
+
+func reconcileTagsReplace(ctx context.Context, networkClient osclients.NetworkClient, resourceType, resourceID string, specTagSet, observedTagSet set.Set[string]) progress.ReconcileStatus {
+	if specTagSet.Equal(observedTagSet) {
+		return nil
+	}
+
+	opts := attributestags.ReplaceAllOpts{Tags: specTagSet.SortedList()}
+	if _, err := networkClient.ReplaceAllAttributesTags(ctx, resourceType, resourceID, &opts); err != nil {
+		return progress.WrapError(err)
+	}
+	// If we updated the tags we need another reconcile to refresh the resource status
+	return progress.NeedsRefresh()
+}
+
+// reconcileTagsMerge assumes osclients.NetworkClient exposes
+// AddTag(ctx, resourceType, resourceID, tag string) error and
+// RemoveTag(ctx, resourceType, resourceID, tag string) error alongside its
+// existing ReplaceAllAttributesTags; that interface lives outside this
+// checkout, so these signatures aren't verified against it here.
+func reconcileTagsMerge(ctx context.Context, networkClient osclients.NetworkClient, resourceType, resourceID string, k8sClient client.Client, orcObject client.Object, managedTagsAnnotation string, specTagSet, observedTagSet set.Set[string]) progress.ReconcileStatus {
+	managedTagSet := set.New(splitManagedTags(orcObject.GetAnnotations()[managedTagsAnnotation])...)
+
+	toAdd := specTagSet.Difference(observedTagSet)
+	toRemove := managedTagSet.Intersection(observedTagSet).Difference(specTagSet)
+
+	if toAdd.Len() == 0 && toRemove.Len() == 0 {
+		return nil
+	}
+
+	for _, tag := range toAdd.SortedList() {
+		if err := networkClient.AddTag(ctx, resourceType, resourceID, tag); err != nil {
+			return progress.WrapError(err)
+		}
+	}
+	for _, tag := range toRemove.SortedList() {
+		if err := networkClient.RemoveTag(ctx, resourceType, resourceID, tag); err != nil {
+			return progress.WrapError(err)
 		}
+	}
+
+	// Persist which tags ORC now manages before returning, otherwise the
+	// next reconcile fetches an object without this annotation, treats
+	// managedTagSet as empty, and can never compute a toRemove again.
+	base := orcObject.DeepCopyObject().(client.Object)
+	setManagedTags(orcObject, managedTagsAnnotation, specTagSet)
+	if err := k8sClient.Patch(ctx, orcObject, client.MergeFrom(base)); err != nil {
+		return progress.WrapError(err)
+	}
+
+	// If we updated the tags we need another reconcile to refresh the resource status
+	return progress.NeedsRefresh()
+}
+
+func splitManagedTags(annotation string) []string {
+	if annotation == "" {
 		return nil
 	}
+	return strings.Split(annotation, ",")
+}
+
+func setManagedTags(orcObject client.Object, managedTagsAnnotation string, managedTagSet set.Set[string]) {
+	annotations := orcObject.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[managedTagsAnnotation] = strings.Join(managedTagSet.SortedList(), ",")
+	orcObject.SetAnnotations(annotations)
 }
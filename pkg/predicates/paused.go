@@ -0,0 +1,65 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// PausedAnnotation is set by operators to temporarily suspend reconciliation
+// of an individual ORC object without removing its finalizers.
+const PausedAnnotation = "orc.openstack.k-orc.cloud/paused"
+
+// IsPaused returns true if obj carries the paused annotation with value
+// "true". Besides NewResourceNotPaused, it is used directly by the generic
+// reconciler.Controller, which skips the reconcile of a paused object and
+// sets its Paused status condition.
+func IsPaused(obj client.Object) bool {
+	return obj.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// NewResourceNotPaused returns a predicate that drops all events for objects
+// carrying the paused annotation, mirroring the pattern cluster-api uses for
+// Cluster.Spec.Paused. The port controller applies it to its cross-resource
+// Watches calls, so a paused dependency doesn't wake up its dependents; it
+// is not applied to the primary For watch, whose reconcile sets the Paused
+// status condition.
+func NewResourceNotPaused(log logr.Logger) predicate.Funcs {
+	log = log.WithValues("predicate", "resourceNotPaused")
+
+	notPaused := func(obj client.Object) bool {
+		if obj == nil {
+			return false
+		}
+		if IsPaused(obj) {
+			log.V(4).Info("resource is paused, skipping reconciliation",
+				"namespace", obj.GetNamespace(), "name", obj.GetName())
+			return false
+		}
+		return true
+	}
+
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return notPaused(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return notPaused(e.ObjectNew) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return notPaused(e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return notPaused(e.Object) },
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package predicates
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// WatchFilterLabel is the label operators set on ORC objects, matched
+// against the manager-wide --watch-filter flag, to shard reconciliation of a
+// single resource type across multiple ORC managers running in the same
+// cluster.
+const WatchFilterLabel = "orc.openstack.k-orc.cloud/watch-filter"
+
+// NewResourceHasFilterLabel returns a predicate that only admits events for
+// objects whose WatchFilterLabel matches watchFilterValue. An empty
+// watchFilterValue disables filtering and admits every object, so that
+// running the manager without --watch-filter preserves today's behaviour.
+func NewResourceHasFilterLabel(log logr.Logger, watchFilterValue string) predicate.Funcs {
+	log = log.WithValues("predicate", "resourceHasFilterLabel")
+
+	hasFilterLabel := func(obj client.Object) bool {
+		if watchFilterValue == "" || obj == nil {
+			return true
+		}
+		if obj.GetLabels()[WatchFilterLabel] != watchFilterValue {
+			log.V(4).Info("resource does not match watch-filter label, skipping reconciliation",
+				"namespace", obj.GetNamespace(), "name", obj.GetName(), "watch-filter", watchFilterValue)
+			return false
+		}
+		return true
+	}
+
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return hasFilterLabel(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return hasFilterLabel(e.ObjectNew) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return hasFilterLabel(e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return hasFilterLabel(e.Object) },
+	}
+}
+
+// ResourceNotPausedAndHasFilterLabel combines NewResourceNotPaused and
+// NewResourceHasFilterLabel into a single predicate. It drops every event
+// for a paused object, so a primary For watch whose reconcile sets the
+// Paused status condition uses NewResourceHasFilterLabel alone instead (as
+// the port controller's SetupWithManager does).
+func ResourceNotPausedAndHasFilterLabel(log logr.Logger, watchFilterValue string) predicate.Predicate {
+	return predicate.And(
+		NewResourceNotPaused(log),
+		NewResourceHasFilterLabel(log, watchFilterValue),
+	)
+}